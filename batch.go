@@ -0,0 +1,168 @@
+package main
+
+import (
+	"bufio"
+	"encoding/json"
+	"fmt"
+	"log"
+	"net/http"
+	"os"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+)
+
+// maxBatchSize caps how many addresses POST /validate-addresses accepts
+// in a single JSON-array request.
+const maxBatchSize = 1000
+
+// defaultBatchConcurrency is used when VALIDATE_BATCH_CONCURRENCY isn't
+// set or isn't a positive integer.
+const defaultBatchConcurrency = 8
+
+// BatchAddressRequest is the body of POST /validate-addresses.
+type BatchAddressRequest struct {
+	Addresses []string `json:"addresses" binding:"required"`
+}
+
+func batchConcurrency() int {
+	if v := os.Getenv("VALIDATE_BATCH_CONCURRENCY"); v != "" {
+		if n, err := strconv.Atoi(v); err == nil && n > 0 {
+			return n
+		}
+	}
+	return defaultBatchConcurrency
+}
+
+func handleBatchValidateAddresses(validator *AddressValidator) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		if r.Method != http.MethodPost {
+			http.Error(w, "Method not allowed", http.StatusMethodNotAllowed)
+			return
+		}
+
+		if strings.HasPrefix(r.Header.Get("Content-Type"), "application/x-ndjson") {
+			streamBatchNDJSON(w, r, validator)
+			return
+		}
+
+		var req BatchAddressRequest
+		if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+			writeErrorResponse(w, "Invalid JSON format", http.StatusBadRequest)
+			return
+		}
+
+		if len(req.Addresses) == 0 {
+			writeErrorResponse(w, "addresses field is required", http.StatusBadRequest)
+			return
+		}
+
+		if len(req.Addresses) > maxBatchSize {
+			writeErrorResponse(w, fmt.Sprintf("addresses exceeds maximum batch size of %d", maxBatchSize), http.StatusBadRequest)
+			return
+		}
+
+		responses := validateBatch(validator, req.Addresses, "", false)
+
+		w.Header().Set("Content-Type", "application/json")
+		if err := json.NewEncoder(w).Encode(responses); err != nil {
+			log.Printf("Error encoding response: %v", err)
+		}
+	}
+}
+
+// validateBatch runs addresses through validator using a bounded worker
+// pool sized by batchConcurrency, preserving input order in the
+// returned slice. A panic validating one address is isolated to that
+// address's response so it can't fail the rest of the batch.
+func validateBatch(validator *AddressValidator, addresses []string, country string, geocode bool) []*AddressResponse {
+	responses := make([]*AddressResponse, len(addresses))
+
+	concurrency := batchConcurrency()
+	if concurrency > len(addresses) {
+		concurrency = len(addresses)
+	}
+
+	jobs := make(chan int)
+	var wg sync.WaitGroup
+	wg.Add(concurrency)
+
+	for w := 0; w < concurrency; w++ {
+		go func() {
+			defer wg.Done()
+			for i := range jobs {
+				responses[i] = safeValidate(validator, addresses[i], country, geocode)
+			}
+		}()
+	}
+
+	for i := range addresses {
+		jobs <- i
+	}
+	close(jobs)
+	wg.Wait()
+
+	return responses
+}
+
+// streamBatchNDJSON reads newline-delimited AddressRequest JSON objects
+// from the request body and writes a newline-delimited AddressResponse
+// for each as soon as it's validated, so arbitrarily large address
+// lists can be piped through without buffering the whole batch.
+func streamBatchNDJSON(w http.ResponseWriter, r *http.Request, validator *AddressValidator) {
+	w.Header().Set("Content-Type", "application/x-ndjson")
+
+	scanner := bufio.NewScanner(r.Body)
+	scanner.Buffer(make([]byte, 0, 64*1024), 1024*1024)
+	encoder := json.NewEncoder(w)
+	flusher, canFlush := w.(http.Flusher)
+
+	for scanner.Scan() {
+		line := strings.TrimSpace(scanner.Text())
+		if line == "" {
+			continue
+		}
+
+		var req AddressRequest
+		if err := json.Unmarshal([]byte(line), &req); err != nil {
+			encoder.Encode(&AddressResponse{
+				OriginalAddress: line,
+				Status:          StatusUnverifiable,
+				Issues: []ValidationIssue{{
+					Code:    CodeInvalidJSON,
+					Message: "malformed NDJSON line: " + err.Error(),
+				}},
+				ProcessedAt: time.Now(),
+			})
+		} else {
+			encoder.Encode(safeValidate(validator, req.Address, req.Country, false))
+		}
+
+		if canFlush {
+			flusher.Flush()
+		}
+	}
+}
+
+// safeValidate wraps AddressValidator.ValidateAndStandardize, isolating
+// a panic on one malformed address into an unverifiable response rather
+// than letting it take down the rest of the batch.
+func safeValidate(validator *AddressValidator, address, country string, geocode bool) (response *AddressResponse) {
+	defer func() {
+		if r := recover(); r != nil {
+			response = &AddressResponse{
+				OriginalAddress: address,
+				Status:          StatusUnverifiable,
+				Issues: []ValidationIssue{{
+					Code:    CodeInternalError,
+					Message: fmt.Sprintf("panic validating address: %v", r),
+				}},
+				ProcessedAt: time.Now(),
+			}
+		}
+	}()
+
+	response, _ = validator.ValidateAndStandardize(address, country, geocode)
+	return response
+}
@@ -0,0 +1,59 @@
+package main
+
+import (
+	"fmt"
+	"os"
+	"testing"
+)
+
+func TestValidateBatchPreservesOrder(t *testing.T) {
+	validator := NewAddressValidator()
+
+	addresses := make([]string, 50)
+	for i := range addresses {
+		addresses[i] = fmt.Sprintf("%d Main St, Springfield, IL 6270%d", i+1, i%10)
+	}
+
+	responses := validateBatch(validator, addresses, "", false)
+
+	if len(responses) != len(addresses) {
+		t.Fatalf("got %d responses, want %d", len(responses), len(addresses))
+	}
+	for i, resp := range responses {
+		if resp == nil {
+			t.Fatalf("response %d is nil", i)
+		}
+		if resp.OriginalAddress != addresses[i] {
+			t.Errorf("response %d = %q, want %q (order not preserved)", i, resp.OriginalAddress, addresses[i])
+		}
+	}
+}
+
+func TestValidateBatchRespectsConcurrencyEnvVar(t *testing.T) {
+	t.Setenv("VALIDATE_BATCH_CONCURRENCY", "3")
+	if got := batchConcurrency(); got != 3 {
+		t.Fatalf("batchConcurrency() = %d, want 3", got)
+	}
+
+	os.Unsetenv("VALIDATE_BATCH_CONCURRENCY")
+	if got := batchConcurrency(); got != defaultBatchConcurrency {
+		t.Fatalf("batchConcurrency() = %d, want default %d", got, defaultBatchConcurrency)
+	}
+}
+
+func TestSafeValidateRecoversPanic(t *testing.T) {
+	panicValidator := NewAddressValidator()
+	panicValidator.geocodeCache = nil // forces a nil-pointer panic when geocode=true
+
+	response := safeValidate(panicValidator, "1 Main St, Springfield, IL 62701", "", true)
+
+	if response == nil {
+		t.Fatal("safeValidate returned nil response")
+	}
+	if response.Status != StatusUnverifiable {
+		t.Errorf("status = %q, want %q", response.Status, StatusUnverifiable)
+	}
+	if len(response.Issues) != 1 || response.Issues[0].Code != CodeInternalError {
+		t.Errorf("issues = %+v, want a single %s issue", response.Issues, CodeInternalError)
+	}
+}
@@ -0,0 +1,63 @@
+package main
+
+import "regexp"
+
+// CountryMetadata describes how an ISO-3166 alpha-2 country expects its
+// postal addresses to be structured, validated and rendered. It mirrors
+// the fields exposed by the Google i18n address metadata service
+// (chromium-i18n.appspot.com/ssl-address); see generator/main.go for how
+// generated.go is produced from that source.
+type CountryMetadata struct {
+	// Name is the English name of the country.
+	Name string
+
+	// RequiredFields and AllowedFields list the address component tokens
+	// (the same %-tokens used in Format) that this country requires or
+	// permits on an address.
+	RequiredFields []string
+	AllowedFields  []string
+
+	// AdministrativeAreaType is the local name for the %S component
+	// (e.g. "state", "province", "region").
+	AdministrativeAreaType string
+	// AdministrativeAreas maps subdivision keys (e.g. "CA") to their
+	// local name.
+	AdministrativeAreas map[string]string
+
+	// LocalityType and SublocalityType are the local names for the %C
+	// and %D components (e.g. "city"/"district").
+	LocalityType    string
+	SublocalityType string
+
+	// PostalCodeType is the local name for the %Z component (e.g. "ZIP",
+	// "Postal Code", "Eircode", "PIN").
+	PostalCodeType string
+	// PostalCodeRegex validates the %Z component for this country.
+	PostalCodeRegex *regexp.Regexp
+
+	// Format lays out the rendering order of an address using the
+	// tokens %N (street number), %O (organization/unit), %A (street
+	// name/address line), %D (sublocality), %C (locality), %S
+	// (administrative area), %Z (postal code) and %X (country).
+	Format string
+}
+
+// countryMetadata is populated by the init func in generated.go, which
+// generator/main.go can regenerate.
+var countryMetadata map[string]*CountryMetadata
+
+// defaultCountry is used whenever AddressRequest.Country is empty.
+const defaultCountry = "US"
+
+// lookupCountry returns the metadata for the given ISO-3166 alpha-2
+// country code, falling back to the default country when the code is
+// unknown or empty.
+func lookupCountry(code string) *CountryMetadata {
+	if code == "" {
+		code = defaultCountry
+	}
+	if meta, ok := countryMetadata[code]; ok {
+		return meta
+	}
+	return countryMetadata[defaultCountry]
+}
@@ -0,0 +1,54 @@
+package main
+
+import "errors"
+
+// Sentinel errors returned (joined together via errors.Join) by
+// AddressValidator.ValidateAndStandardize whenever validation turns up
+// one or more issues. Library consumers can use errors.Is instead of
+// inspecting AddressResponse.Issues.
+var (
+	ErrMissingField    = errors.New("required address field is missing")
+	ErrInvalidState    = errors.New("administrative area is not recognized")
+	ErrInvalidPostCode = errors.New("postal code is not valid for this country")
+	ErrUnparseable     = errors.New("address could not be parsed")
+)
+
+// Machine-readable codes used in ValidationIssue.Code.
+const (
+	CodeEmptyAddress        = "EmptyAddress"
+	CodeUnparseable         = "Unparseable"
+	CodeMissingStreetNumber = "MissingStreetNumber"
+	CodeMissingStreetName   = "MissingStreetName"
+	CodeMissingCity         = "MissingCity"
+	CodeMissingState        = "MissingState"
+	CodeUnknownState        = "UnknownState"
+	CodeMissingPostCode     = "MissingPostCode"
+	CodeInvalidPostCode     = "InvalidPostCode"
+	CodeCorrectedStreetName = "CorrectedStreetName"
+	CodeInvalidJSON         = "InvalidJSON"
+	CodeInternalError       = "InternalError"
+)
+
+// issueAggregator collects ValidationIssues alongside the sentinel
+// errors they correspond to, so a single pass over an address's
+// components can produce both the structured issue list returned to
+// HTTP callers and an errors.Is-compatible error returned to library
+// callers.
+type issueAggregator struct {
+	issues []ValidationIssue
+	errs   []error
+}
+
+func (a *issueAggregator) add(code, field, message string, sentinel error) {
+	a.issues = append(a.issues, ValidationIssue{Code: code, Field: field, Message: message})
+	a.errs = append(a.errs, sentinel)
+}
+
+// err joins the sentinel errors recorded so far, or returns nil if
+// nothing was added.
+func (a *issueAggregator) err() error {
+	if len(a.errs) == 0 {
+		return nil
+	}
+	return errors.Join(a.errs...)
+}
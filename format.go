@@ -0,0 +1,101 @@
+package main
+
+import (
+	"fmt"
+	"html"
+	"strings"
+)
+
+// AddressFormat selects a rendering convention for ValidatedAddress.Format.
+type AddressFormat string
+
+const (
+	FormatSingleLine AddressFormat = "single-line"
+	FormatMultiLine  AddressFormat = "multi-line"
+	FormatPostal     AddressFormat = "postal"
+	FormatHTML       AddressFormat = "html"
+)
+
+// Format renders addr according to format. Unknown formats fall back to
+// FormatSingleLine (the existing FullAddress rendering), so this mirrors
+// what bojanz/address exposes without requiring callers to special-case
+// an invalid ?format= query value.
+func (addr *ValidatedAddress) Format(format AddressFormat) string {
+	switch format {
+	case FormatPostal:
+		return addr.formatPostal()
+	case FormatHTML:
+		return addr.formatHTML()
+	case FormatMultiLine:
+		return addr.formatMultiLine()
+	default:
+		return addr.FullAddress
+	}
+}
+
+// formatMultiLine renders addr's already-ordered FullAddress groups one
+// per line instead of comma-separated.
+func (addr *ValidatedAddress) formatMultiLine() string {
+	if addr.FullAddress == "" {
+		return ""
+	}
+	return strings.Join(strings.Split(addr.FullAddress, ", "), "\n")
+}
+
+// formatPostal renders addr the way USPS mailing labels do: a single
+// all-caps line with commas and periods dropped (other characters, such
+// as the ZIP+4 hyphen or '#', are kept).
+func (addr *ValidatedAddress) formatPostal() string {
+	if addr.FullAddress == "" {
+		return ""
+	}
+
+	replacer := strings.NewReplacer(",", "", ".", "")
+	groups := strings.Split(addr.FullAddress, ", ")
+	for i, group := range groups {
+		groups[i] = strings.ToUpper(replacer.Replace(group))
+	}
+
+	return strings.Join(groups, " ")
+}
+
+// formatHTML renders addr as an h-adr microformat fragment suitable for
+// embedding directly in a page.
+func (addr *ValidatedAddress) formatHTML() string {
+	var b strings.Builder
+	b.WriteString(`<p class="h-adr">`)
+
+	if addr.StreetNumber != "" || addr.StreetName != "" {
+		streetLine := strings.TrimSpace(addr.StreetNumber + " " + addr.StreetName)
+		if addr.Unit != "" {
+			streetLine += " " + addr.Unit
+		}
+		fmt.Fprintf(&b, `<span class="p-street-address address-line1">%s</span>`, html.EscapeString(streetLine))
+	}
+
+	if addr.City != "" {
+		fmt.Fprintf(&b, `<span class="p-locality address-line2">%s</span>`, html.EscapeString(addr.City))
+	}
+
+	if addr.State != "" {
+		fmt.Fprintf(&b, `<span class="p-region">%s</span>`, html.EscapeString(addr.State))
+	}
+
+	if zip := addr.fullZip(); zip != "" {
+		fmt.Fprintf(&b, `<span class="p-postal-code">%s</span>`, html.EscapeString(zip))
+	}
+
+	if addr.Country != "" {
+		fmt.Fprintf(&b, `<span class="p-country-name">%s</span>`, html.EscapeString(addr.Country))
+	}
+
+	b.WriteString(`</p>`)
+	return b.String()
+}
+
+func (addr *ValidatedAddress) fullZip() string {
+	if addr.ZipPlus4 == "" {
+		return addr.ZipCode
+	}
+	return addr.ZipCode + "-" + addr.ZipPlus4
+}
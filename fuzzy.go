@@ -0,0 +1,150 @@
+package main
+
+import (
+	"fmt"
+	"strings"
+)
+
+// streetDirections are the directional words fuzzy-corrected alongside
+// street suffixes (e.g. "Norht" -> "North").
+var streetDirections = []string{
+	"North", "South", "East", "West",
+	"Northeast", "Northwest", "Southeast", "Southwest",
+}
+
+// buildStreetNameDictionary returns the canonical USPS street suffixes
+// (full names, not abbreviations) plus directional words, used as the
+// candidate set for fuzzy street-name correction.
+func buildStreetNameDictionary() []string {
+	seen := make(map[string]bool)
+	var dict []string
+
+	for _, full := range getStreetSuffixes() {
+		if !seen[full] {
+			seen[full] = true
+			dict = append(dict, full)
+		}
+	}
+
+	dict = append(dict, streetDirections...)
+	return dict
+}
+
+// correctStreetNameTypos fixes common street-name misspellings word by
+// word. It first tries the fast-path commonMisspellings lookup, then
+// falls back to fuzzy Damerau-Levenshtein matching against the
+// validator's street-name dictionary: a match within the threshold (1
+// for tokens of 5 characters or fewer, 2 otherwise) is applied only
+// when it's unambiguous (no other candidate is equally close). Each
+// substitution is recorded as a ValidationIssue so callers can see what
+// changed.
+func (av *AddressValidator) correctStreetNameTypos(streetName string) (string, []ValidationIssue) {
+	words := strings.Fields(streetName)
+	var issues []ValidationIssue
+
+	for i, word := range words {
+		if fast, ok := av.commonMisspellings[word]; ok {
+			words[i] = fast
+			issues = append(issues, correctedStreetNameIssue(word, fast))
+			continue
+		}
+
+		if corrected, ok := fuzzyCorrectWord(word, av.streetNameDictionary); ok {
+			words[i] = corrected
+			issues = append(issues, correctedStreetNameIssue(word, corrected))
+		}
+	}
+
+	return strings.Join(words, " "), issues
+}
+
+func correctedStreetNameIssue(original, corrected string) ValidationIssue {
+	return ValidationIssue{
+		Code:    CodeCorrectedStreetName,
+		Field:   "street_name",
+		Message: fmt.Sprintf("corrected %q to %q", original, corrected),
+	}
+}
+
+// fuzzyCorrectWord finds the dictionary entry closest to word by
+// Damerau-Levenshtein distance. It returns ok=false when word already
+// matches a dictionary entry (nothing to correct), when no candidate is
+// within the length-scaled threshold, or when two or more candidates
+// are equally close (ambiguous).
+func fuzzyCorrectWord(word string, dictionary []string) (string, bool) {
+	if word == "" {
+		return "", false
+	}
+
+	threshold := 2
+	if len(word) <= 5 {
+		threshold = 1
+	}
+
+	bestDist := -1
+	var best string
+	ambiguous := false
+
+	for _, candidate := range dictionary {
+		if strings.EqualFold(word, candidate) {
+			return "", false
+		}
+
+		dist := damerauLevenshtein(strings.ToLower(word), strings.ToLower(candidate))
+		if dist > threshold {
+			continue
+		}
+
+		switch {
+		case bestDist == -1 || dist < bestDist:
+			bestDist = dist
+			best = candidate
+			ambiguous = false
+		case dist == bestDist && candidate != best:
+			ambiguous = true
+		}
+	}
+
+	if bestDist == -1 || ambiguous {
+		return "", false
+	}
+
+	return best, true
+}
+
+// damerauLevenshtein computes the Damerau-Levenshtein edit distance
+// (insertions, deletions, substitutions and adjacent transpositions)
+// between a and b.
+func damerauLevenshtein(a, b string) int {
+	ra, rb := []rune(a), []rune(b)
+	la, lb := len(ra), len(rb)
+
+	d := make([][]int, la+1)
+	for i := range d {
+		d[i] = make([]int, lb+1)
+		d[i][0] = i
+	}
+	for j := 0; j <= lb; j++ {
+		d[0][j] = j
+	}
+
+	for i := 1; i <= la; i++ {
+		for j := 1; j <= lb; j++ {
+			cost := 1
+			if ra[i-1] == rb[j-1] {
+				cost = 0
+			}
+
+			d[i][j] = min(
+				d[i-1][j]+1,
+				min(d[i][j-1]+1, d[i-1][j-1]+cost),
+			)
+
+			if i > 1 && j > 1 && ra[i-1] == rb[j-2] && ra[i-2] == rb[j-1] {
+				d[i][j] = min(d[i][j], d[i-2][j-2]+cost)
+			}
+		}
+	}
+
+	return d[la][lb]
+}
@@ -0,0 +1,64 @@
+package main
+
+import "testing"
+
+func TestCorrectStreetNameTyposSuffixes(t *testing.T) {
+	av := NewAddressValidator()
+
+	tests := map[string]string{
+		"Main Steet":     "Main Street",
+		"Main Streat":    "Main Street",
+		"Grand Bulevard": "Grand Boulevard",
+		"Ocean Aveune":   "Ocean Avenue",
+		"Norht Main":     "North Main",
+	}
+
+	for input, want := range tests {
+		got, issues := av.correctStreetNameTypos(input)
+		if got != want {
+			t.Errorf("correctStreetNameTypos(%q) = %q, want %q", input, got, want)
+		}
+		if len(issues) == 0 {
+			t.Errorf("correctStreetNameTypos(%q) recorded no issues, expected a correction", input)
+		}
+	}
+}
+
+func TestCorrectStreetNameTyposNoChange(t *testing.T) {
+	av := NewAddressValidator()
+
+	got, issues := av.correctStreetNameTypos("Main Street")
+	if got != "Main Street" {
+		t.Errorf("correctStreetNameTypos(%q) = %q, want unchanged", "Main Street", got)
+	}
+	if len(issues) != 0 {
+		t.Errorf("correctStreetNameTypos(%q) recorded %d issues, want 0", "Main Street", len(issues))
+	}
+}
+
+func TestFuzzyCorrectWordAmbiguous(t *testing.T) {
+	// "Day" is one substitution from both "Way" and "Bay", so the match
+	// is ambiguous and must be rejected rather than guessed at.
+	dict := []string{"Way", "Bay"}
+	if _, ok := fuzzyCorrectWord("Day", dict); ok {
+		t.Errorf("fuzzyCorrectWord(%q) matched ambiguously, want no correction", "Day")
+	}
+}
+
+func TestDamerauLevenshtein(t *testing.T) {
+	tests := []struct {
+		a, b string
+		want int
+	}{
+		{"Street", "Street", 0},
+		{"Steet", "Street", 1},
+		{"Norht", "North", 1},
+		{"Aveune", "Avenue", 1},
+	}
+
+	for _, tt := range tests {
+		if got := damerauLevenshtein(tt.a, tt.b); got != tt.want {
+			t.Errorf("damerauLevenshtein(%q, %q) = %d, want %d", tt.a, tt.b, got, tt.want)
+		}
+	}
+}
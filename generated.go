@@ -0,0 +1,96 @@
+// Country metadata in the shape generator/main.go emits. The entries
+// below are hand-tuned (the US entry reuses getStateAbbreviations, and
+// digit-only postal code regexes are not case-insensitive); running the
+// generator replaces them with data fetched from the Google i18n address
+// metadata (chromium-i18n.appspot.com/ssl-address).
+//
+// To regenerate: go run ./generator -out generated.go
+
+package main
+
+import "regexp"
+
+func init() {
+	countryMetadata = map[string]*CountryMetadata{
+		"US": {
+			Name:                   "United States",
+			RequiredFields:         []string{"%N", "%A", "%C", "%S", "%Z"},
+			AllowedFields:          []string{"%N", "%O", "%A", "%D", "%C", "%S", "%Z"},
+			AdministrativeAreaType: "state",
+			AdministrativeAreas:    getStateAbbreviations(),
+			LocalityType:           "city",
+			PostalCodeType:         "ZIP Code",
+			PostalCodeRegex:        regexp.MustCompile(`^\d{5}(-\d{4})?$`),
+			Format:                 "%N %A %O, %C, %S %Z",
+		},
+		"CA": {
+			Name:                   "Canada",
+			RequiredFields:         []string{"%N", "%A", "%C", "%S", "%Z"},
+			AllowedFields:          []string{"%N", "%O", "%A", "%C", "%S", "%Z"},
+			AdministrativeAreaType: "province",
+			AdministrativeAreas: map[string]string{
+				"AB": "Alberta", "BC": "British Columbia", "MB": "Manitoba",
+				"NB": "New Brunswick", "NL": "Newfoundland and Labrador",
+				"NS": "Nova Scotia", "NT": "Northwest Territories", "NU": "Nunavut",
+				"ON": "Ontario", "PE": "Prince Edward Island", "QC": "Quebec",
+				"SK": "Saskatchewan", "YT": "Yukon",
+			},
+			LocalityType:    "city",
+			PostalCodeType:  "Postal Code",
+			PostalCodeRegex: regexp.MustCompile(`(?i)^[A-Z]\d[A-Z] ?\d[A-Z]\d$`),
+			Format:          "%N %A %O, %C, %S %Z",
+		},
+		"GB": {
+			Name:                   "United Kingdom",
+			RequiredFields:         []string{"%A", "%C", "%Z"},
+			AllowedFields:          []string{"%N", "%O", "%A", "%D", "%C", "%Z"},
+			AdministrativeAreaType: "county",
+			LocalityType:           "post town",
+			PostalCodeType:         "Postcode",
+			PostalCodeRegex: regexp.MustCompile(
+				`(?i)^[A-Z]{1,2}\d[A-Z\d]? ?\d[A-Z]{2}$`),
+			Format: "%N %A %O, %D, %C, %Z",
+		},
+		"IE": {
+			Name:                   "Ireland",
+			RequiredFields:         []string{"%A", "%C"},
+			AllowedFields:          []string{"%N", "%O", "%A", "%D", "%C", "%S", "%Z"},
+			AdministrativeAreaType: "county",
+			LocalityType:           "city",
+			PostalCodeType:         "Eircode",
+			PostalCodeRegex: regexp.MustCompile(
+				`(?i)^[A-Z]\d{2} ?[A-Z0-9]{4}$`),
+			Format: "%N %A %O, %D, %C, %S %Z",
+		},
+		"IN": {
+			Name:                   "India",
+			RequiredFields:         []string{"%N", "%A", "%C", "%S", "%Z"},
+			AllowedFields:          []string{"%N", "%O", "%A", "%D", "%C", "%S", "%Z"},
+			AdministrativeAreaType: "state",
+			LocalityType:           "city",
+			PostalCodeType:         "PIN Code",
+			PostalCodeRegex:        regexp.MustCompile(`^\d{6}$`),
+			Format:                 "%N %A %O, %D, %C, %S %Z",
+		},
+		"DE": {
+			Name:                   "Germany",
+			RequiredFields:         []string{"%A", "%C", "%Z"},
+			AllowedFields:          []string{"%N", "%O", "%A", "%C", "%Z"},
+			AdministrativeAreaType: "state",
+			LocalityType:           "city",
+			PostalCodeType:         "Postal Code",
+			PostalCodeRegex:        regexp.MustCompile(`^\d{5}$`),
+			Format:                 "%A %N %O, %Z %C",
+		},
+		"JP": {
+			Name:                   "Japan",
+			RequiredFields:         []string{"%A", "%C", "%S", "%Z"},
+			AllowedFields:          []string{"%N", "%O", "%A", "%D", "%C", "%S", "%Z"},
+			AdministrativeAreaType: "prefecture",
+			LocalityType:           "city",
+			PostalCodeType:         "Postal Code",
+			PostalCodeRegex:        regexp.MustCompile(`^\d{3}-?\d{4}$`),
+			Format:                 "%Z %S %C %D %A %N %O",
+		},
+	}
+}
@@ -0,0 +1,265 @@
+// Command generator fetches the Google i18n address metadata from
+// chromium-i18n.appspot.com/ssl-address and emits generated.go, a
+// static Go map of CountryMetadata so the validation service has no
+// network dependency at runtime.
+//
+// Usage:
+//
+//	go run ./generator -out generated.go
+//	go run ./generator -countries US,CA,GB -out generated.go
+package main
+
+import (
+	"bytes"
+	"encoding/json"
+	"flag"
+	"fmt"
+	"go/format"
+	"io"
+	"log"
+	"net/http"
+	"os"
+	"regexp"
+	"sort"
+	"strings"
+	"text/template"
+	"time"
+)
+
+const metadataBaseURL = "https://chromium-i18n.appspot.com/ssl-address/data"
+
+// i18nCountry mirrors the subset of the Google i18n address metadata
+// response that the validator needs.
+type i18nCountry struct {
+	Key             string `json:"key"`
+	Name            string `json:"name"`
+	Fmt             string `json:"fmt"`
+	Require         string `json:"require"`
+	StateType       string `json:"state_name_type"`
+	LocalityType    string `json:"locality_name_type"`
+	SublocalityType string `json:"sublocality_name_type"`
+	ZipType         string `json:"zip_name_type"`
+	ZipEx           string `json:"zip"`
+	Sub1Keys        string `json:"sub_keys"`
+	Sub1Names       string `json:"sub_names"`
+}
+
+var defaultCountries = []string{
+	"US", "CA", "GB", "IE", "IN", "DE", "JP",
+}
+
+// renderedCountry holds a CountryMetadata's fields pre-rendered into
+// Go-literal-shaped data so the template only has to print them, not
+// derive them.
+type renderedCountry struct {
+	Key                    string
+	Name                   string
+	RequiredFields         []string
+	AllowedFields          []string
+	AdministrativeAreaType string
+	AdministrativeAreas    []areaEntry
+	LocalityType           string
+	SublocalityType        string
+	PostalCodeType         string
+	PostalCodeRegex        string
+	Format                 string
+}
+
+type areaEntry struct {
+	Key  string
+	Name string
+}
+
+func main() {
+	out := flag.String("out", "generated.go", "output path for the generated Go source")
+	countriesFlag := flag.String("countries", strings.Join(defaultCountries, ","), "comma-separated ISO-3166 alpha-2 country codes to fetch")
+	flag.Parse()
+
+	codes := strings.Split(*countriesFlag, ",")
+	client := &http.Client{Timeout: 15 * time.Second}
+
+	rendered := make([]renderedCountry, 0, len(codes))
+	for _, code := range codes {
+		c, err := fetchCountry(client, code)
+		if err != nil {
+			log.Fatalf("fetching metadata for %s: %v", code, err)
+		}
+		rendered = append(rendered, renderCountry(c))
+	}
+
+	var buf bytes.Buffer
+	if err := generatedTemplate.Execute(&buf, rendered); err != nil {
+		log.Fatalf("rendering template: %v", err)
+	}
+
+	formatted, err := format.Source(buf.Bytes())
+	if err != nil {
+		log.Fatalf("formatting generated source: %v", err)
+	}
+
+	if err := os.WriteFile(*out, formatted, 0644); err != nil {
+		log.Fatalf("writing %s: %v", *out, err)
+	}
+
+	fmt.Printf("wrote %d countries to %s\n", len(rendered), *out)
+}
+
+func fetchCountry(client *http.Client, code string) (i18nCountry, error) {
+	resp, err := client.Get(metadataBaseURL + "/" + code)
+	if err != nil {
+		return i18nCountry{}, err
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode != http.StatusOK {
+		return i18nCountry{}, fmt.Errorf("unexpected status %s", resp.Status)
+	}
+
+	body, err := io.ReadAll(resp.Body)
+	if err != nil {
+		return i18nCountry{}, err
+	}
+
+	var c i18nCountry
+	if err := json.Unmarshal(body, &c); err != nil {
+		return i18nCountry{}, err
+	}
+	return c, nil
+}
+
+// renderCountry translates the raw i18n fields into a renderedCountry,
+// matching them up with the %-tokens and CountryMetadata shape the
+// validator expects.
+func renderCountry(c i18nCountry) renderedCountry {
+	return renderedCountry{
+		Key:                    c.Key,
+		Name:                   c.Name,
+		RequiredFields:         requireTokens(c.Require),
+		AllowedFields:          formatTokens(c.Fmt),
+		AdministrativeAreaType: c.StateType,
+		AdministrativeAreas:    administrativeAreas(c.Sub1Keys, c.Sub1Names),
+		LocalityType:           c.LocalityType,
+		SublocalityType:        c.SublocalityType,
+		PostalCodeType:         c.ZipType,
+		PostalCodeRegex:        anchorRegex(c.ZipEx),
+		// buildFullAddress splits lines on "\n"; i18n marks them with "%n".
+		Format: strings.ReplaceAll(c.Fmt, "%n", "\n"),
+	}
+}
+
+// requireTokens turns the i18n metadata's "require" string (a run of
+// %-token letters, e.g. "ACSZ") into the %-prefixed tokens
+// CountryMetadata.RequiredFields expects.
+func requireTokens(require string) []string {
+	if require == "" {
+		return nil
+	}
+	tokens := make([]string, 0, len(require))
+	for _, r := range require {
+		tokens = append(tokens, "%"+string(r))
+	}
+	return tokens
+}
+
+var formatTokenPattern = regexp.MustCompile(`%[A-Z]`)
+
+// formatTokens lists, in first-seen order, the distinct %-tokens that
+// appear in an i18n "fmt" string, for CountryMetadata.AllowedFields.
+func formatTokens(format string) []string {
+	seen := make(map[string]bool)
+	var tokens []string
+	for _, token := range formatTokenPattern.FindAllString(format, -1) {
+		if !seen[token] {
+			seen[token] = true
+			tokens = append(tokens, token)
+		}
+	}
+	return tokens
+}
+
+// administrativeAreas zips the i18n metadata's "~"-delimited sub_keys
+// and sub_names into key/name pairs, sorted by key for a deterministic
+// diff across regenerations.
+func administrativeAreas(keys, names string) []areaEntry {
+	if keys == "" || names == "" {
+		return nil
+	}
+	keyList := strings.Split(keys, "~")
+	nameList := strings.Split(names, "~")
+	if len(keyList) != len(nameList) {
+		return nil
+	}
+
+	areas := make([]areaEntry, len(keyList))
+	for i := range keyList {
+		areas[i] = areaEntry{Key: keyList[i], Name: nameList[i]}
+	}
+	sort.Slice(areas, func(i, j int) bool { return areas[i].Key < areas[j].Key })
+	return areas
+}
+
+// anchorRegex anchors an i18n "zip" pattern to the full string and
+// makes it case-insensitive, matching the hand-tuned regexes it
+// replaces (postal codes mixing letters and digits, e.g. the UK's, are
+// conventionally matched case-insensitively).
+func anchorRegex(pattern string) string {
+	if pattern == "" {
+		return ""
+	}
+	if !strings.HasPrefix(pattern, "^") {
+		pattern = "^" + pattern
+	}
+	if !strings.HasSuffix(pattern, "$") {
+		pattern = pattern + "$"
+	}
+	return "(?i)" + pattern
+}
+
+var generatedTemplate = template.Must(template.New("generated.go").Parse(`// Code generated by generator/main.go from the Google i18n address
+// metadata (chromium-i18n.appspot.com/ssl-address). DO NOT EDIT.
+//
+// To refresh: go run ./generator -out generated.go
+
+package main
+
+import "regexp"
+
+func init() {
+	countryMetadata = map[string]*CountryMetadata{
+{{- range .}}
+		"{{.Key}}": {
+			Name: {{printf "%q" .Name}},
+{{- if .RequiredFields}}
+			RequiredFields: []string{ {{- range $i, $f := .RequiredFields}}{{if $i}},{{end}} {{printf "%q" $f}}{{end}} },
+{{- end}}
+{{- if .AllowedFields}}
+			AllowedFields: []string{ {{- range $i, $f := .AllowedFields}}{{if $i}},{{end}} {{printf "%q" $f}}{{end}} },
+{{- end}}
+{{- if .AdministrativeAreaType}}
+			AdministrativeAreaType: {{printf "%q" .AdministrativeAreaType}},
+{{- end}}
+{{- if .AdministrativeAreas}}
+			AdministrativeAreas: map[string]string{
+{{- range .AdministrativeAreas}}
+				{{printf "%q" .Key}}: {{printf "%q" .Name}},
+{{- end}}
+			},
+{{- end}}
+{{- if .LocalityType}}
+			LocalityType: {{printf "%q" .LocalityType}},
+{{- end}}
+{{- if .SublocalityType}}
+			SublocalityType: {{printf "%q" .SublocalityType}},
+{{- end}}
+{{- if .PostalCodeType}}
+			PostalCodeType: {{printf "%q" .PostalCodeType}},
+{{- end}}
+{{- if .PostalCodeRegex}}
+			PostalCodeRegex: regexp.MustCompile({{printf "%q" .PostalCodeRegex}}),
+{{- end}}
+			Format: {{printf "%q" .Format}},
+		},
+{{- end}}
+	}
+}
+`))
@@ -0,0 +1,74 @@
+package main
+
+import (
+	"container/list"
+	"sync"
+)
+
+// defaultGeocodeCacheCapacity bounds memory use for the LRU cache below;
+// it's generous enough for most deployments without a config knob.
+const defaultGeocodeCacheCapacity = 1000
+
+// geocodeCache is a fixed-capacity, concurrency-safe LRU cache mapping a
+// full address to its previously resolved coordinates, so repeat
+// ValidateAndStandardize(..., geocode=true) calls for the same address
+// don't re-hit the configured Geocoder.
+type geocodeCache struct {
+	mu       sync.Mutex
+	capacity int
+	entries  map[string]*list.Element
+	order    *list.List
+}
+
+type geocodeCacheEntry struct {
+	key      string
+	lat, lng float64
+}
+
+func newGeocodeCache(capacity int) *geocodeCache {
+	if capacity <= 0 {
+		capacity = defaultGeocodeCacheCapacity
+	}
+	return &geocodeCache{
+		capacity: capacity,
+		entries:  make(map[string]*list.Element),
+		order:    list.New(),
+	}
+}
+
+func (c *geocodeCache) get(key string) (lat, lng float64, ok bool) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	el, exists := c.entries[key]
+	if !exists {
+		return 0, 0, false
+	}
+
+	c.order.MoveToFront(el)
+	entry := el.Value.(*geocodeCacheEntry)
+	return entry.lat, entry.lng, true
+}
+
+func (c *geocodeCache) put(key string, lat, lng float64) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	if el, exists := c.entries[key]; exists {
+		c.order.MoveToFront(el)
+		entry := el.Value.(*geocodeCacheEntry)
+		entry.lat, entry.lng = lat, lng
+		return
+	}
+
+	el := c.order.PushFront(&geocodeCacheEntry{key: key, lat: lat, lng: lng})
+	c.entries[key] = el
+
+	if c.order.Len() > c.capacity {
+		oldest := c.order.Back()
+		if oldest != nil {
+			c.order.Remove(oldest)
+			delete(c.entries, oldest.Value.(*geocodeCacheEntry).key)
+		}
+	}
+}
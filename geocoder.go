@@ -0,0 +1,131 @@
+package main
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net/http"
+	"net/url"
+	"strconv"
+	"time"
+)
+
+// ErrGeocodingUnavailable is returned by NoopGeocoder, and wrapped by
+// NominatimGeocoder and CensusGeocoder when the service finds no match
+// for an address. Their transport and decoding errors are returned as
+// they are, without wrapping it.
+var ErrGeocodingUnavailable = errors.New("geocoding is not available for this address")
+
+// Geocoder resolves a full address string to WGS84 coordinates.
+type Geocoder interface {
+	Geocode(address string) (lat, lng float64, err error)
+}
+
+// NoopGeocoder is the default Geocoder: it never resolves coordinates,
+// so the validation service works fully offline unless a real Geocoder
+// is wired in via NewAddressValidatorWithGeocoder.
+type NoopGeocoder struct{}
+
+func (NoopGeocoder) Geocode(address string) (float64, float64, error) {
+	return 0, 0, ErrGeocodingUnavailable
+}
+
+// NominatimGeocoder resolves addresses using the OpenStreetMap Nominatim
+// public search API.
+type NominatimGeocoder struct {
+	BaseURL string
+	Client  *http.Client
+}
+
+// NewNominatimGeocoder returns a NominatimGeocoder pointed at the public
+// OSM instance with a conservative request timeout.
+func NewNominatimGeocoder() *NominatimGeocoder {
+	return &NominatimGeocoder{
+		BaseURL: "https://nominatim.openstreetmap.org",
+		Client:  &http.Client{Timeout: 10 * time.Second},
+	}
+}
+
+func (g *NominatimGeocoder) Geocode(address string) (float64, float64, error) {
+	endpoint := fmt.Sprintf("%s/search?format=json&limit=1&q=%s", g.BaseURL, url.QueryEscape(address))
+
+	req, err := http.NewRequest(http.MethodGet, endpoint, nil)
+	if err != nil {
+		return 0, 0, err
+	}
+	req.Header.Set("User-Agent", "validate-address/1.0")
+
+	resp, err := g.Client.Do(req)
+	if err != nil {
+		return 0, 0, err
+	}
+	defer resp.Body.Close()
+
+	var results []struct {
+		Lat string `json:"lat"`
+		Lon string `json:"lon"`
+	}
+	if err := json.NewDecoder(resp.Body).Decode(&results); err != nil {
+		return 0, 0, err
+	}
+	if len(results) == 0 {
+		return 0, 0, fmt.Errorf("%w: nominatim returned no results for %q", ErrGeocodingUnavailable, address)
+	}
+
+	lat, err := strconv.ParseFloat(results[0].Lat, 64)
+	if err != nil {
+		return 0, 0, err
+	}
+	lng, err := strconv.ParseFloat(results[0].Lon, 64)
+	if err != nil {
+		return 0, 0, err
+	}
+
+	return lat, lng, nil
+}
+
+// CensusGeocoder resolves US addresses using the US Census Bureau's
+// onelineaddress geocoder. It is only meaningful for country "US".
+type CensusGeocoder struct {
+	BaseURL string
+	Client  *http.Client
+}
+
+// NewCensusGeocoder returns a CensusGeocoder pointed at the public
+// geocoding.geo.census.gov endpoint with a conservative request timeout.
+func NewCensusGeocoder() *CensusGeocoder {
+	return &CensusGeocoder{
+		BaseURL: "https://geocoding.geo.census.gov/geocoder/locations/onelineaddress",
+		Client:  &http.Client{Timeout: 10 * time.Second},
+	}
+}
+
+func (g *CensusGeocoder) Geocode(address string) (float64, float64, error) {
+	endpoint := fmt.Sprintf("%s?address=%s&benchmark=Public_AR_Current&format=json", g.BaseURL, url.QueryEscape(address))
+
+	resp, err := g.Client.Get(endpoint)
+	if err != nil {
+		return 0, 0, err
+	}
+	defer resp.Body.Close()
+
+	var payload struct {
+		Result struct {
+			AddressMatches []struct {
+				Coordinates struct {
+					X float64 `json:"x"`
+					Y float64 `json:"y"`
+				} `json:"coordinates"`
+			} `json:"addressMatches"`
+		} `json:"result"`
+	}
+	if err := json.NewDecoder(resp.Body).Decode(&payload); err != nil {
+		return 0, 0, err
+	}
+	if len(payload.Result.AddressMatches) == 0 {
+		return 0, 0, fmt.Errorf("%w: census geocoder returned no matches for %q", ErrGeocodingUnavailable, address)
+	}
+
+	match := payload.Result.AddressMatches[0]
+	return match.Coordinates.Y, match.Coordinates.X, nil
+}
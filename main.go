@@ -5,20 +5,37 @@ import (
 	"fmt"
 	"log"
 	"net/http"
+	"os"
 )
 
 func main() {
-	validator := NewAddressValidator()
-	
+	validator := NewAddressValidatorWithGeocoder(selectGeocoder())
+
 	mux := http.NewServeMux()
 	
 	mux.HandleFunc("POST /validate-address", handleValidateAddress(validator))
+	mux.HandleFunc("POST /validate-addresses", handleBatchValidateAddresses(validator))
 	mux.HandleFunc("GET /health", handleHealthCheck)
 	
 	fmt.Println("Starting address validation API server on :8080")
 	log.Fatal(http.ListenAndServe(":8080", mux))
 }
 
+// selectGeocoder builds the Geocoder the service geocodes ?geocode=true
+// requests with, chosen via VALIDATE_GEOCODER ("nominatim" or "census").
+// Geocoding defaults to off (NoopGeocoder) so the service has no
+// external network dependency unless an operator opts in.
+func selectGeocoder() Geocoder {
+	switch os.Getenv("VALIDATE_GEOCODER") {
+	case "nominatim":
+		return NewNominatimGeocoder()
+	case "census":
+		return NewCensusGeocoder()
+	default:
+		return NoopGeocoder{}
+	}
+}
+
 func handleValidateAddress(validator *AddressValidator) http.HandlerFunc {
 	return func(w http.ResponseWriter, r *http.Request) {
 		if r.Method != http.MethodPost {
@@ -37,10 +54,15 @@ func handleValidateAddress(validator *AddressValidator) http.HandlerFunc {
 			return
 		}
 
-		response, err := validator.ValidateAndStandardize(req.Address)
-		if err != nil {
-			writeErrorResponse(w, "Internal server error", http.StatusInternalServerError)
-			return
+		geocode := r.URL.Query().Get("geocode") == "true"
+
+		// The returned error mirrors response.Issues for library callers
+		// doing errors.Is checks; the HTTP API reports issues in the body
+		// regardless of status, so it's not treated as a request failure.
+		response, _ := validator.ValidateAndStandardize(req.Address, req.Country, geocode)
+
+		if format := r.URL.Query().Get("format"); format != "" && response.ValidatedAddress != nil {
+			response.Formatted = response.ValidatedAddress.Format(AddressFormat(format))
 		}
 
 		w.Header().Set("Content-Type", "application/json")
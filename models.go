@@ -4,14 +4,30 @@ import "time"
 
 type AddressRequest struct {
 	Address string `json:"address" binding:"required"`
+	// Country is the ISO-3166 alpha-2 country the address should be
+	// validated against. Defaults to "US" when empty.
+	Country string `json:"country,omitempty"`
 }
 
 type AddressResponse struct {
-	Status          string           `json:"status"`
-	OriginalAddress string           `json:"original_address"`
+	Status           string            `json:"status"`
+	OriginalAddress  string            `json:"original_address"`
 	ValidatedAddress *ValidatedAddress `json:"validated_address,omitempty"`
-	Message         string           `json:"message,omitempty"`
-	ProcessedAt     time.Time        `json:"processed_at"`
+	Issues           []ValidationIssue `json:"issues,omitempty"`
+	// Formatted holds the ?format= rendering of ValidatedAddress, when
+	// requested.
+	Formatted   string    `json:"formatted,omitempty"`
+	ProcessedAt time.Time `json:"processed_at"`
+}
+
+// ValidationIssue describes a single problem found while validating an
+// address: a machine-readable Code (e.g. "MissingStreetNumber",
+// "InvalidPostCode", "UnknownState"), a human-readable Message, and the
+// Field the issue applies to.
+type ValidationIssue struct {
+	Code    string `json:"code"`
+	Message string `json:"message"`
+	Field   string `json:"field,omitempty"`
 }
 
 type ValidatedAddress struct {
@@ -23,7 +39,15 @@ type ValidatedAddress struct {
 	ZipCode      string `json:"zip_code,omitempty"`
 	ZipPlus4     string `json:"zip_plus4,omitempty"`
 	County       string `json:"county,omitempty"`
+	Country      string `json:"country,omitempty"`
 	FullAddress  string `json:"full_address"`
+
+	// Latitude, Longitude and PlusCode are populated only when geocoding
+	// was requested and the configured Geocoder resolved the address.
+	Latitude      float64 `json:"latitude,omitempty"`
+	Longitude     float64 `json:"longitude,omitempty"`
+	PlusCode      string  `json:"plus_code,omitempty"`
+	ShortPlusCode string  `json:"short_plus_code,omitempty"`
 }
 
 const (
@@ -0,0 +1,89 @@
+package main
+
+import "strings"
+
+// olcAlphabet is the 20-symbol alphabet used by Open Location Code
+// (Plus Codes), chosen to avoid visual ambiguity (no 0/O, 1/I/l, etc).
+const olcAlphabet = "23456789CFGHJMPQRVWX"
+
+const (
+	olcBase              = len(olcAlphabet)
+	olcPairCount         = 5 // 10 characters total
+	olcSeparator         = '+'
+	olcSeparatorPosition = 8
+)
+
+// EncodePlusCode computes the Open Location Code for a latitude and
+// longitude, e.g. "849VCWC8+R9". It encodes the coordinate as 5 pairs of
+// base-20 digits, one pair for latitude+longitude at each precision
+// level, each pair narrowing the bounding box by 20x; a '+' separator is
+// inserted after the 8th character per the OLC spec.
+func EncodePlusCode(lat, lng float64) string {
+	lat = clampLatitude(lat)
+	lng = normalizeLongitude(lng)
+
+	latVal := lat + 90.0
+	lngVal := lng + 180.0
+
+	// OLC's first pair uses 20-degree cells on both axes (400 / 20).
+	latRange := 400.0
+	lngRange := 400.0
+
+	var code strings.Builder
+	for i := 0; i < olcPairCount; i++ {
+		latRange /= float64(olcBase)
+		lngRange /= float64(olcBase)
+
+		latDigit := int(latVal / latRange)
+		lngDigit := int(lngVal / lngRange)
+
+		latVal -= float64(latDigit) * latRange
+		lngVal -= float64(lngDigit) * lngRange
+
+		code.WriteByte(olcAlphabet[latDigit])
+		code.WriteByte(olcAlphabet[lngDigit])
+
+		if code.Len() == olcSeparatorPosition {
+			code.WriteByte(olcSeparator)
+		}
+	}
+
+	return code.String()
+}
+
+// ShortPlusCode drops the first 4 characters of a full Plus Code (the
+// two coarsest pairs, which cover a large region) and appends the given
+// city as the reference locality needed to disambiguate it, e.g.
+// "849VCWC8+R9" with city "Springfield" becomes "CWC8+R9 Springfield".
+func ShortPlusCode(fullCode, city string) string {
+	if len(fullCode) <= 4 {
+		return fullCode
+	}
+	short := fullCode[4:]
+	if city == "" {
+		return short
+	}
+	return short + " " + city
+}
+
+func clampLatitude(lat float64) float64 {
+	switch {
+	case lat < -90:
+		return -90
+	case lat >= 90:
+		// OLC treats the north pole as just inside the last cell.
+		return 89.999999999
+	default:
+		return lat
+	}
+}
+
+func normalizeLongitude(lng float64) float64 {
+	for lng < -180 {
+		lng += 360
+	}
+	for lng >= 180 {
+		lng -= 360
+	}
+	return lng
+}
@@ -0,0 +1,45 @@
+package main
+
+import "testing"
+
+func TestEncodePlusCode(t *testing.T) {
+	tests := []struct {
+		name     string
+		lat, lng float64
+		want     string
+	}{
+		{"null island", 0, 0, "6FG22222+22"},
+		{"south-west corner", -90, -180, "22222222+22"},
+		{"north-east corner ends in X digits", 89.999999999, 179.999999, "CVXXXXXX+XX"},
+		{"zurich", 47.3658, 8.5247, "8FVC9G8F+8V"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := EncodePlusCode(tt.lat, tt.lng); got != tt.want {
+				t.Errorf("EncodePlusCode(%v, %v) = %q, want %q", tt.lat, tt.lng, got, tt.want)
+			}
+		})
+	}
+}
+
+func TestShortPlusCode(t *testing.T) {
+	tests := []struct {
+		name     string
+		fullCode string
+		city     string
+		want     string
+	}{
+		{"drops leading 4 chars and appends city", "849VCWC8+R9", "Springfield", "CWC8+R9 Springfield"},
+		{"no city appended", "849VCWC8+R9", "", "CWC8+R9"},
+		{"short input returned unchanged", "849V", "Springfield", "849V"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := ShortPlusCode(tt.fullCode, tt.city); got != tt.want {
+				t.Errorf("ShortPlusCode(%q, %q) = %q, want %q", tt.fullCode, tt.city, got, tt.want)
+			}
+		})
+	}
+}
@@ -0,0 +1,652 @@
+package main
+
+import (
+	"regexp"
+	"strings"
+	"time"
+)
+
+type AddressValidator struct {
+	stateAbbreviations   map[string]string
+	commonMisspellings   map[string]string
+	streetSuffixes       map[string]string
+	streetNameDictionary []string
+	geocoder             Geocoder
+	geocodeCache         *geocodeCache
+}
+
+func NewAddressValidator() *AddressValidator {
+	return &AddressValidator{
+		stateAbbreviations:   getStateAbbreviations(),
+		commonMisspellings:   getCommonMisspellings(),
+		streetSuffixes:       getStreetSuffixes(),
+		streetNameDictionary: buildStreetNameDictionary(),
+		geocoder:             NoopGeocoder{},
+		geocodeCache:         newGeocodeCache(defaultGeocodeCacheCapacity),
+	}
+}
+
+// NewAddressValidatorWithGeocoder builds an AddressValidator that resolves
+// coordinates and Plus Codes through geocoder when callers opt in via
+// ValidateAndStandardize's geocode parameter.
+func NewAddressValidatorWithGeocoder(geocoder Geocoder) *AddressValidator {
+	av := NewAddressValidator()
+	av.geocoder = geocoder
+	return av
+}
+
+func (av *AddressValidator) ValidateAndStandardize(address string, country string, geocode bool) (*AddressResponse, error) {
+	if country == "" {
+		country = defaultCountry
+	}
+	meta := lookupCountry(country)
+
+	response := &AddressResponse{
+		OriginalAddress: address,
+		ProcessedAt:     time.Now(),
+	}
+
+	cleanedAddress := av.cleanAddress(address)
+	if cleanedAddress == "" {
+		response.Status = StatusUnverifiable
+		response.Issues = []ValidationIssue{{
+			Code:    CodeEmptyAddress,
+			Message: "Address is empty or contains only invalid characters",
+		}}
+		return response, ErrUnparseable
+	}
+
+	parsedAddress := av.parseAddress(cleanedAddress, country)
+	if parsedAddress == nil {
+		response.Status = StatusUnverifiable
+		response.Issues = []ValidationIssue{{
+			Code:    CodeUnparseable,
+			Message: "Unable to parse address components",
+		}}
+		return response, ErrUnparseable
+	}
+	parsedAddress.Country = country
+
+	standardizedAddress, corrections := av.standardizeAddress(parsedAddress, meta)
+
+	if geocode {
+		av.geocodeAddress(standardizedAddress)
+	}
+
+	result := av.validateComponents(standardizedAddress, meta)
+
+	status := result.status
+	if len(corrections) > 0 && status == StatusValid {
+		status = StatusCorrected
+	}
+
+	response.ValidatedAddress = standardizedAddress
+	response.Status = status
+	response.Issues = append(corrections, result.issues...)
+
+	return response, result.err
+}
+
+// geocodeAddress resolves addr's coordinates through the validator's
+// configured Geocoder, consulting the LRU cache first, and populates
+// addr's Latitude, Longitude, PlusCode and ShortPlusCode. A Geocoder
+// error (including the NoopGeocoder default) just leaves those fields
+// unset.
+func (av *AddressValidator) geocodeAddress(addr *ValidatedAddress) {
+	if addr == nil || addr.FullAddress == "" {
+		return
+	}
+
+	lat, lng, ok := av.geocodeCache.get(addr.FullAddress)
+	if !ok {
+		var err error
+		lat, lng, err = av.geocoder.Geocode(addr.FullAddress)
+		if err != nil {
+			return
+		}
+		av.geocodeCache.put(addr.FullAddress, lat, lng)
+	}
+
+	addr.Latitude = lat
+	addr.Longitude = lng
+	addr.PlusCode = EncodePlusCode(lat, lng)
+	addr.ShortPlusCode = ShortPlusCode(addr.PlusCode, addr.City)
+}
+
+func (av *AddressValidator) cleanAddress(address string) string {
+	if len(address) > 500 {
+		return ""
+	}
+
+	address = strings.TrimSpace(address)
+	if address == "" {
+		return ""
+	}
+
+	address = regexp.MustCompile(`\s+`).ReplaceAllString(address, " ")
+	address = regexp.MustCompile(`[^\w\s\-\.#/,]`).ReplaceAllString(address, "")
+
+	if len(strings.TrimSpace(address)) < 5 {
+		return ""
+	}
+
+	return address
+}
+
+// parseAddress dispatches to a country-specific parser. Only the US
+// parser is regex-based today; other countries' address formats vary
+// too widely for a single hard-coded pattern, so they go through
+// parseInternationalAddress, which locates the postal code and
+// administrative area using the country's own CountryMetadata rather
+// than assuming US conventions.
+func (av *AddressValidator) parseAddress(address string, country string) *ValidatedAddress {
+	if country != "" && country != defaultCountry {
+		return av.parseInternationalAddress(address, lookupCountry(country))
+	}
+	return av.parseUSAddress(address)
+}
+
+func (av *AddressValidator) parseUSAddress(address string) *ValidatedAddress {
+	patterns := []struct {
+		regex *regexp.Regexp
+		parse func([]string) *ValidatedAddress
+	}{
+		{
+			regexp.MustCompile(`(?i)^(\d+)\s+([^,]+?)(?:\s+(apt|apartment|unit|ste|suite|#)\s*([^,]+?))?\s*,?\s*([^,]+?)\s*,?\s*([a-z]{2})\s+(\d{5})(?:-(\d{4}))?$`),
+			av.parseFullAddress,
+		},
+		{
+			regexp.MustCompile(`(?i)^(\d+)\s+([^,]+?)\s*,?\s*([^,]+?)\s*,?\s*([a-z]{2})\s+(\d{5})(?:-(\d{4}))?$`),
+			av.parseSimpleAddress,
+		},
+	}
+
+	for _, pattern := range patterns {
+		if matches := pattern.regex.FindStringSubmatch(address); matches != nil {
+			return pattern.parse(matches)
+		}
+	}
+
+	return av.parsePartialAddress(address)
+}
+
+func (av *AddressValidator) parseFullAddress(matches []string) *ValidatedAddress {
+	return &ValidatedAddress{
+		StreetNumber: matches[1],
+		StreetName:   matches[2],
+		Unit:         matches[4],
+		City:         matches[5],
+		State:        strings.ToUpper(matches[6]),
+		ZipCode:      matches[7],
+		ZipPlus4:     matches[8],
+	}
+}
+
+func (av *AddressValidator) parseSimpleAddress(matches []string) *ValidatedAddress {
+	return &ValidatedAddress{
+		StreetNumber: matches[1],
+		StreetName:   matches[2],
+		City:         matches[3],
+		State:        strings.ToUpper(matches[4]),
+		ZipCode:      matches[5],
+		ZipPlus4:     matches[6],
+	}
+}
+
+func (av *AddressValidator) parsePartialAddress(address string) *ValidatedAddress {
+	parts := strings.Fields(address)
+	if len(parts) < 2 {
+		return nil
+	}
+
+	result := &ValidatedAddress{}
+
+	if matched, _ := regexp.MatchString(`^\d+`, parts[0]); matched {
+		result.StreetNumber = parts[0]
+		parts = parts[1:]
+	}
+
+	for i, part := range parts {
+		if av.isState(part) {
+			result.State = strings.ToUpper(part)
+			if i > 0 {
+				result.City = strings.Join(parts[:i], " ")
+			}
+			if i < len(parts)-1 && av.isZipCode(parts[i+1]) {
+				result.ZipCode = parts[i+1]
+			}
+			break
+		}
+	}
+
+	if result.StreetNumber != "" && result.State == "" {
+		for i := len(parts) - 1; i >= 0; i-- {
+			if av.isZipCode(parts[i]) {
+				result.ZipCode = parts[i]
+				if i > 0 && av.isState(parts[i-1]) {
+					result.State = strings.ToUpper(parts[i-1])
+					if i > 1 {
+						result.City = strings.Join(parts[:i-1], " ")
+					}
+				}
+				break
+			}
+		}
+	}
+
+	if result.StreetNumber != "" && result.City == "" && result.State == "" {
+		result.StreetName = strings.Join(parts, " ")
+	}
+
+	return result
+}
+
+// parseInternationalAddress parses a comma-delimited address for any
+// country other than the US, using meta's PostalCodeRegex and
+// AdministrativeAreas to locate the postal code and administrative
+// area instead of the US-only isState/isZipCode helpers. It expects
+// street, locality and area/postal code to appear as separate
+// comma-separated segments, e.g. "10 Downing Street, London, SW1A 2AA".
+func (av *AddressValidator) parseInternationalAddress(address string, meta *CountryMetadata) *ValidatedAddress {
+	var segments []string
+	for _, seg := range strings.Split(address, ",") {
+		if seg = strings.TrimSpace(seg); seg != "" {
+			segments = append(segments, seg)
+		}
+	}
+	if len(segments) == 0 {
+		return nil
+	}
+
+	result := &ValidatedAddress{}
+
+	streetParts := strings.Fields(segments[0])
+	if len(streetParts) == 0 {
+		return nil
+	}
+	if matched, _ := regexp.MatchString(`^\d+`, streetParts[0]); matched {
+		result.StreetNumber = streetParts[0]
+		streetParts = streetParts[1:]
+	}
+	result.StreetName = strings.Join(streetParts, " ")
+
+	rest := segments[1:]
+	if len(rest) > 0 {
+		last := rest[len(rest)-1]
+		rest = rest[:len(rest)-1]
+
+		postal, area := extractPostalAndArea(last, meta)
+		result.ZipCode = postal
+		result.State = area
+
+		result.City = strings.Join(rest, " ")
+	}
+
+	if result.StreetName == "" && result.City == "" && result.State == "" && result.ZipCode == "" {
+		return nil
+	}
+
+	return result
+}
+
+// extractPostalAndArea finds the longest run of whitespace-delimited
+// tokens in segment that matches meta's PostalCodeRegex (postal codes
+// like the UK's embed a space, so a single token isn't always enough)
+// and returns it alongside whatever tokens remain, which are assumed to
+// name the administrative area. If meta has no postal code pattern, or
+// none of segment matches it, segment is returned whole as the area.
+func extractPostalAndArea(segment string, meta *CountryMetadata) (postal, area string) {
+	tokens := strings.Fields(segment)
+	if meta != nil && meta.PostalCodeRegex != nil {
+		for windowLen := len(tokens); windowLen >= 1; windowLen-- {
+			for start := 0; start+windowLen <= len(tokens); start++ {
+				candidate := strings.Join(tokens[start:start+windowLen], " ")
+				if meta.PostalCodeRegex.MatchString(candidate) {
+					remaining := append(append([]string{}, tokens[:start]...), tokens[start+windowLen:]...)
+					return candidate, strings.Join(remaining, " ")
+				}
+			}
+		}
+	}
+	return "", segment
+}
+
+func (av *AddressValidator) standardizeAddress(addr *ValidatedAddress, meta *CountryMetadata) (*ValidatedAddress, []ValidationIssue) {
+	if addr == nil {
+		return nil, nil
+	}
+
+	var corrections []ValidationIssue
+
+	if addr.StreetName != "" {
+		streetName, issues := av.standardizeStreetName(addr.StreetName)
+		addr.StreetName = streetName
+		corrections = append(corrections, issues...)
+	}
+
+	if addr.City != "" {
+		addr.City = av.standardizeCity(addr.City)
+	}
+
+	if addr.State != "" {
+		addr.State = av.standardizeAdministrativeArea(addr.State, meta)
+	}
+
+	addr.FullAddress = av.buildFullAddress(addr, meta)
+
+	return addr, corrections
+}
+
+func (av *AddressValidator) standardizeStreetName(streetName string) (string, []ValidationIssue) {
+	streetName = strings.TrimSpace(streetName)
+	streetName = av.toTitleCase(streetName)
+
+	for abbrev, full := range av.streetSuffixes {
+		pattern := regexp.MustCompile(`(?i)\b` + regexp.QuoteMeta(abbrev) + `\b`)
+		streetName = pattern.ReplaceAllString(streetName, full)
+	}
+
+	return av.correctStreetNameTypos(streetName)
+}
+
+func (av *AddressValidator) standardizeCity(city string) string {
+	city = strings.TrimSpace(city)
+	return av.toTitleCase(city)
+}
+
+// standardizeAdministrativeArea normalizes a state/province/region name
+// or abbreviation to its canonical key, consulting the subdivisions of
+// the given country's metadata when present (falling back to the US
+// state table for the default country).
+func (av *AddressValidator) standardizeAdministrativeArea(area string, meta *CountryMetadata) string {
+	area = strings.TrimSpace(strings.ToUpper(area))
+
+	areas := av.stateAbbreviations
+	if meta != nil {
+		areas = meta.AdministrativeAreas
+	}
+
+	if _, exists := areas[area]; exists {
+		return area
+	}
+
+	for key, fullName := range areas {
+		if strings.EqualFold(area, fullName) {
+			return key
+		}
+	}
+
+	return area
+}
+
+// buildFullAddress renders addr using meta's format string, which lays
+// out address components with the tokens %N (street number), %O
+// (unit), %A (street name), %D (sublocality), %C (city), %S
+// (administrative area), %Z (postal code) and %X (country). Empty
+// tokens, and any punctuation left dangling by their removal, are
+// trimmed from the result.
+func (av *AddressValidator) buildFullAddress(addr *ValidatedAddress, meta *CountryMetadata) string {
+	format := "%N %A %O, %C, %S %Z"
+	if meta != nil && meta.Format != "" {
+		format = meta.Format
+	}
+
+	zip := addr.ZipCode
+	if addr.ZipPlus4 != "" {
+		zip += "-" + addr.ZipPlus4
+	}
+
+	tokens := map[string]string{
+		"%N": addr.StreetNumber,
+		"%O": addr.Unit,
+		"%A": addr.StreetName,
+		"%D": "",
+		"%C": addr.City,
+		"%S": addr.State,
+		"%Z": zip,
+		"%X": addr.Country,
+	}
+
+	rendered := format
+	for token, value := range tokens {
+		rendered = strings.ReplaceAll(rendered, token, value)
+	}
+
+	return cleanupFormattedAddress(rendered)
+}
+
+// cleanupFormattedAddress collapses the whitespace and punctuation left
+// behind once empty tokens are removed from a rendered format string.
+func cleanupFormattedAddress(s string) string {
+	lines := strings.Split(s, "\n")
+	for i, line := range lines {
+		line = regexp.MustCompile(`\s+`).ReplaceAllString(line, " ")
+		line = regexp.MustCompile(`\s*,\s*,`).ReplaceAllString(line, ",")
+		line = regexp.MustCompile(`^[,\s]+|[,\s]+$`).ReplaceAllString(line, "")
+		line = regexp.MustCompile(`\s+,`).ReplaceAllString(line, ",")
+		lines[i] = strings.TrimSpace(line)
+	}
+
+	nonEmpty := lines[:0]
+	for _, line := range lines {
+		if line != "" {
+			nonEmpty = append(nonEmpty, line)
+		}
+	}
+
+	return strings.Join(nonEmpty, ", ")
+}
+
+type validationResult struct {
+	status string
+	issues []ValidationIssue
+	err    error
+}
+
+func (av *AddressValidator) validateComponents(addr *ValidatedAddress, meta *CountryMetadata) validationResult {
+	if addr == nil {
+		return validationResult{status: StatusUnverifiable, err: ErrUnparseable}
+	}
+
+	agg := &issueAggregator{}
+
+	areaType, postalType := "state", "ZIP code"
+	if meta != nil {
+		if meta.AdministrativeAreaType != "" {
+			areaType = meta.AdministrativeAreaType
+		}
+		if meta.PostalCodeType != "" {
+			postalType = meta.PostalCodeType
+		}
+	}
+
+	if addr.StreetNumber == "" {
+		if fieldRequired(meta, "%N") {
+			agg.add(CodeMissingStreetNumber, "street_number", "street number is missing", ErrMissingField)
+		}
+	}
+
+	if addr.StreetName == "" {
+		if fieldRequired(meta, "%A") {
+			agg.add(CodeMissingStreetName, "street_name", "street name is missing", ErrMissingField)
+		}
+	}
+
+	if addr.City == "" {
+		if fieldRequired(meta, "%C") {
+			agg.add(CodeMissingCity, "city", "city is missing", ErrMissingField)
+		}
+	}
+
+	if addr.State == "" {
+		if fieldRequired(meta, "%S") {
+			agg.add(CodeMissingState, "state", areaType+" is missing", ErrMissingField)
+		}
+	} else if fieldAllowed(meta, "%S") && !av.isValidAdministrativeArea(addr.State, meta) {
+		agg.add(CodeUnknownState, "state", "unrecognized "+areaType+": "+addr.State, ErrInvalidState)
+	}
+
+	if addr.ZipCode == "" {
+		if fieldRequired(meta, "%Z") {
+			agg.add(CodeMissingPostCode, "zip_code", postalType+" is missing", ErrMissingField)
+		}
+	} else if !av.isValidPostalCode(addr.ZipCode, meta) {
+		agg.add(CodeInvalidPostCode, "zip_code", "invalid "+postalType+": "+addr.ZipCode, ErrInvalidPostCode)
+	}
+
+	if len(agg.issues) > 2 {
+		return validationResult{status: StatusUnverifiable, issues: agg.issues, err: agg.err()}
+	}
+
+	if len(agg.issues) > 0 {
+		return validationResult{status: StatusCorrected, issues: agg.issues, err: agg.err()}
+	}
+
+	return validationResult{status: StatusValid}
+}
+
+// fieldRequired reports whether meta's RequiredFields lists token (one
+// of the %-tokens used in CountryMetadata.Format). A nil meta, or one
+// with no RequiredFields of its own, requires every field, matching the
+// US-shaped defaults this validator started with.
+func fieldRequired(meta *CountryMetadata, token string) bool {
+	if meta == nil || meta.RequiredFields == nil {
+		return true
+	}
+	for _, t := range meta.RequiredFields {
+		if t == token {
+			return true
+		}
+	}
+	return false
+}
+
+// fieldAllowed reports whether meta's AllowedFields lists token. A nil
+// meta, or one with no AllowedFields of its own, allows every field.
+func fieldAllowed(meta *CountryMetadata, token string) bool {
+	if meta == nil || meta.AllowedFields == nil {
+		return true
+	}
+	for _, t := range meta.AllowedFields {
+		if t == token {
+			return true
+		}
+	}
+	return false
+}
+
+func (av *AddressValidator) isState(s string) bool {
+	s = strings.ToUpper(strings.TrimSpace(s))
+	_, exists := av.stateAbbreviations[s]
+	if exists {
+		return true
+	}
+
+	for _, fullName := range av.stateAbbreviations {
+		if strings.EqualFold(s, fullName) {
+			return true
+		}
+	}
+
+	return false
+}
+
+func (av *AddressValidator) isValidState(state string) bool {
+	_, exists := av.stateAbbreviations[strings.ToUpper(state)]
+	return exists
+}
+
+// isValidAdministrativeArea checks state against the given country's
+// subdivisions when known; countries without an enumerated subdivision
+// list (most of them) accept any non-empty value. Only a nil meta (no
+// country could be resolved at all) falls back to the US state table.
+func (av *AddressValidator) isValidAdministrativeArea(state string, meta *CountryMetadata) bool {
+	if meta == nil {
+		return av.isValidState(state)
+	}
+	if meta.AdministrativeAreas == nil {
+		return true
+	}
+	_, exists := meta.AdministrativeAreas[strings.ToUpper(state)]
+	return exists
+}
+
+func (av *AddressValidator) isZipCode(s string) bool {
+	matched, _ := regexp.MatchString(`^\d{5}(-\d{4})?$`, s)
+	return matched
+}
+
+func (av *AddressValidator) isValidZipCode(zipCode string) bool {
+	return av.isZipCode(zipCode)
+}
+
+// isValidPostalCode validates zip against the given country's postal
+// code regex, falling back to the US ZIP format when no country-specific
+// regex is available.
+func (av *AddressValidator) isValidPostalCode(zip string, meta *CountryMetadata) bool {
+	if meta == nil || meta.PostalCodeRegex == nil {
+		return av.isValidZipCode(zip)
+	}
+	return meta.PostalCodeRegex.MatchString(zip)
+}
+
+func (av *AddressValidator) toTitleCase(s string) string {
+	words := strings.Fields(strings.ToLower(s))
+	for i, word := range words {
+		if len(word) > 0 {
+			words[i] = strings.ToUpper(string(word[0])) + word[1:]
+		}
+	}
+	return strings.Join(words, " ")
+}
+
+func getStateAbbreviations() map[string]string {
+	return map[string]string{
+		"AL": "Alabama", "AK": "Alaska", "AZ": "Arizona", "AR": "Arkansas", "CA": "California",
+		"CO": "Colorado", "CT": "Connecticut", "DE": "Delaware", "FL": "Florida", "GA": "Georgia",
+		"HI": "Hawaii", "ID": "Idaho", "IL": "Illinois", "IN": "Indiana", "IA": "Iowa",
+		"KS": "Kansas", "KY": "Kentucky", "LA": "Louisiana", "ME": "Maine", "MD": "Maryland",
+		"MA": "Massachusetts", "MI": "Michigan", "MN": "Minnesota", "MS": "Mississippi", "MO": "Missouri",
+		"MT": "Montana", "NE": "Nebraska", "NV": "Nevada", "NH": "New Hampshire", "NJ": "New Jersey",
+		"NM": "New Mexico", "NY": "New York", "NC": "North Carolina", "ND": "North Dakota", "OH": "Ohio",
+		"OK": "Oklahoma", "OR": "Oregon", "PA": "Pennsylvania", "RI": "Rhode Island", "SC": "South Carolina",
+		"SD": "South Dakota", "TN": "Tennessee", "TX": "Texas", "UT": "Utah", "VT": "Vermont",
+		"VA": "Virginia", "WA": "Washington", "WV": "West Virginia", "WI": "Wisconsin", "WY": "Wyoming",
+		"DC": "District of Columbia",
+	}
+}
+
+func getCommonMisspellings() map[string]string {
+	return map[string]string{
+		"Steet":   "Street",
+		"Streat":  "Street",
+		"Streeet": "Street",
+		"Avenu":   "Avenue",
+		"Aveune":  "Avenue",
+		"Blvd":    "Boulevard",
+		"Rd":      "Road",
+		"Dr":      "Drive",
+		"Ct":      "Court",
+		"Ln":      "Lane",
+		"Pl":      "Place",
+		"Pkwy":    "Parkway",
+	}
+}
+
+func getStreetSuffixes() map[string]string {
+	return map[string]string{
+		"St":   "Street",
+		"Ave":  "Avenue",
+		"Blvd": "Boulevard",
+		"Rd":   "Road",
+		"Dr":   "Drive",
+		"Ct":   "Court",
+		"Ln":   "Lane",
+		"Pl":   "Place",
+		"Pkwy": "Parkway",
+		"Cir":  "Circle",
+		"Way":  "Way",
+		"Trl":  "Trail",
+		"Ter":  "Terrace",
+	}
+}